@@ -0,0 +1,111 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+)
+
+// frameSTX 是应用层帧的起始字节
+const frameSTX byte = 0x7E
+
+// ackFlag 标记 Cmd 字段的最高位，置位表示这是一条 ACK 帧
+const ackFlag byte = 0x80
+
+// Frame 是在 RawMessage 之上封装的应用层帧：
+//
+//	[STX | seq | cmd | len | payload... | xor_checksum]
+//
+// 用于在 CAN 帧这种尽力而为的传输上实现基于 ACK 的可靠投递
+type Frame struct {
+	Seq     byte
+	Cmd     byte
+	Payload []byte
+}
+
+// EncodeFrame 将一个帧序列化为字节切片，校验和按 `sum ^= data[i]` 对 STX 之后的全部字节计算
+func EncodeFrame(f Frame) []byte {
+	body := make([]byte, 0, 3+len(f.Payload))
+	body = append(body, f.Seq, f.Cmd, byte(len(f.Payload)))
+	body = append(body, f.Payload...)
+
+	var checksum byte
+	for _, b := range body {
+		checksum ^= b
+	}
+
+	frame := make([]byte, 0, len(body)+2)
+	frame = append(frame, frameSTX)
+	frame = append(frame, body...)
+	frame = append(frame, checksum)
+	return frame
+}
+
+// DecodeFrame 解析一个应用层帧并校验 XOR 校验和；校验不匹配时返回错误，
+// 调用方应当将其计入 DeviceStatus.ErrorCount 并丢弃该帧
+func DecodeFrame(raw []byte) (Frame, error) {
+	if len(raw) < 5 {
+		return Frame{}, fmt.Errorf("帧长度过短: %d", len(raw))
+	}
+	if raw[0] != frameSTX {
+		return Frame{}, fmt.Errorf("帧起始符不匹配: 0x%X", raw[0])
+	}
+
+	body := raw[1 : len(raw)-1]
+	checksum := raw[len(raw)-1]
+
+	var sum byte
+	for _, b := range body {
+		sum ^= b
+	}
+	if sum != checksum {
+		return Frame{}, fmt.Errorf("帧校验和不匹配: 期望 0x%X, 实际 0x%X", sum, checksum)
+	}
+
+	length := int(body[2])
+	if len(body) != 3+length {
+		return Frame{}, fmt.Errorf("帧长度字段与实际负载不符")
+	}
+
+	return Frame{
+		Seq:     body[0],
+		Cmd:     body[1],
+		Payload: body[3:],
+	}, nil
+}
+
+// MaxCanFrameLen 是经典 CAN 2.0 总线单帧数据域的最大字节数
+const MaxCanFrameLen = 8
+
+// FragmentFrame 把一个编码后的应用层帧按 maxChunk 字节切分为多段物理 CAN 消息的 Data，
+// 用于在总线单帧 8 字节的限制下发送超出该长度的帧（如携带手指姿态负载的指令帧）；
+// maxChunk 非正数时回退为 MaxCanFrameLen。对端按到达顺序依次拼接分片即可还原出完整的帧。
+func FragmentFrame(framed []byte, maxChunk int) [][]byte {
+	if maxChunk <= 0 {
+		maxChunk = MaxCanFrameLen
+	}
+
+	chunks := make([][]byte, 0, (len(framed)+maxChunk-1)/maxChunk)
+	for len(framed) > 0 {
+		n := min(maxChunk, len(framed))
+		chunks = append(chunks, framed[:n])
+		framed = framed[n:]
+	}
+	return chunks
+}
+
+// IsAck 判断一个帧是否为 ACK 帧
+func IsAck(f Frame) bool {
+	return f.Cmd&ackFlag != 0
+}
+
+// AckFor 构造一个针对给定序列号和原始 Cmd 的 ACK 帧
+func AckFor(seq, cmd byte) Frame {
+	return Frame{Seq: seq, Cmd: cmd | ackFlag}
+}
+
+// FrameReceiver 是 Communicator 的可选扩展接口：支持监听指定 CAN 接口上的入站原始消息。
+// 并非所有 Communicator 实现都需要支持接收（例如只发送的网关），因此单独定义为可选接口，
+// 使用方通过类型断言判断具体的 Communicator 是否支持
+type FrameReceiver interface {
+	ReceiveMessage(ctx context.Context, iface string) (RawMessage, error)
+}
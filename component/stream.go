@@ -0,0 +1,14 @@
+package component
+
+import (
+	"context"
+
+	"hands/device"
+)
+
+// StreamingSensor 是 Sensor 的可选扩展：支持主动推送读数，而不是被动等待 ReadData() 轮询。
+// 实现了该接口的传感器组件可以把高频数据（如触觉、关节角）直接喂给 device.StreamBroker，
+// 不支持的组件则由 broker 按配置的速率轮询 ReadData() 兜底
+type StreamingSensor interface {
+	Stream(ctx context.Context) <-chan device.SensorData
+}
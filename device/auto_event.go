@@ -0,0 +1,234 @@
+package device
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// AutoEventKind 自动事件的类型，对应设备可以周期性执行的动作
+type AutoEventKind string
+
+const (
+	AutoEventSensorRead    AutoEventKind = "SensorRead"
+	AutoEventExecutePreset AutoEventKind = "ExecutePreset"
+	AutoEventSetFingerPose AutoEventKind = "SetFingerPose"
+	AutoEventAnimation     AutoEventKind = "Animation"
+)
+
+// AutoEvent 描述一个命名的周期性任务：多久触发一次、触发什么动作、携带哪些参数
+type AutoEvent struct {
+	Name     string
+	Kind     AutoEventKind
+	Interval time.Duration
+	Params   map[string]any
+}
+
+// AutoEventTarget 是 AutoEventManager 可以驱动的设备需要实现的最小接口
+type AutoEventTarget interface {
+	GetID() string
+	ReadSensorData() (SensorData, error)
+	ExecutePreset(presetName string) error
+	SetFingerPose(pose []byte) error
+	PlayAnimation(name string) error
+	// PublishReading 把一条传感器读数发布到设备自身的流式数据 broker（即 Subscribe
+	// 的数据源），使 SensorRead 自动事件的结果能和手动轮询/主动推送的数据一样被
+	// dashboard 的同一条订阅流看到，而不是单独维护一套订阅者
+	PublishReading(data SensorData)
+}
+
+// AutoEventManager 按设备管理周期性任务（自动事件）。每个设备的每个事件各自拥有一个
+// goroutine 按 Interval 调度；SensorRead 的结果通过 target.PublishReading 发布到设备
+// 自身的 StreamBroker，和其它来源的 Reading 共用同一条订阅流，而不是另起一套订阅者列表
+type AutoEventManager struct {
+	mutex   sync.RWMutex
+	targets map[string]AutoEventTarget
+	events  map[string]map[string]AutoEvent // deviceID -> eventName -> event
+	stopCh  map[string]map[string]chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewAutoEventManager 创建一个空的自动事件管理器
+func NewAutoEventManager() *AutoEventManager {
+	return &AutoEventManager{
+		targets: make(map[string]AutoEventTarget),
+		events:  make(map[string]map[string]AutoEvent),
+		stopCh:  make(map[string]map[string]chan struct{}),
+	}
+}
+
+// RegisterDevice 将设备注册到管理器，使其自动事件可以被调度
+func (m *AutoEventManager) RegisterDevice(target AutoEventTarget) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	id := target.GetID()
+	m.targets[id] = target
+	if _, ok := m.events[id]; !ok {
+		m.events[id] = make(map[string]AutoEvent)
+		m.stopCh[id] = make(map[string]chan struct{})
+	}
+}
+
+// RegisterEvent 为指定设备注册一个命名的自动事件；事件注册后并不会立即启动，
+// 需要调用 StartAutoEvents 或 RestartForDevice
+func (m *AutoEventManager) RegisterEvent(deviceID string, evt AutoEvent) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.targets[deviceID]; !ok {
+		return fmt.Errorf("设备 %s 尚未注册到自动事件管理器", deviceID)
+	}
+	if evt.Interval <= 0 {
+		return fmt.Errorf("自动事件 %s 的调度周期必须大于 0", evt.Name)
+	}
+	m.events[deviceID][evt.Name] = evt
+	return nil
+}
+
+// RemoveEvent 移除指定设备上已注册的自动事件，如果该事件正在运行会先停止它
+func (m *AutoEventManager) RemoveEvent(deviceID, name string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if stop, ok := m.stopCh[deviceID][name]; ok {
+		close(stop)
+		delete(m.stopCh[deviceID], name)
+	}
+	delete(m.events[deviceID], name)
+	return nil
+}
+
+// StartAutoEvents 启动所有已注册设备的所有自动事件
+func (m *AutoEventManager) StartAutoEvents() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for deviceID, evts := range m.events {
+		for name, evt := range evts {
+			m.startLocked(deviceID, name, evt)
+		}
+	}
+	return nil
+}
+
+// startLocked 启动单个自动事件的调度 goroutine，调用方必须已持有写锁
+func (m *AutoEventManager) startLocked(deviceID, name string, evt AutoEvent) {
+	if _, running := m.stopCh[deviceID][name]; running {
+		return
+	}
+	stop := make(chan struct{})
+	m.stopCh[deviceID][name] = stop
+	m.wg.Add(1)
+	go m.run(deviceID, evt, stop)
+}
+
+// run 是单个自动事件的调度循环，按 Interval 周期触发，直到 stop 被关闭
+func (m *AutoEventManager) run(deviceID string, evt AutoEvent, stop chan struct{}) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(evt.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			m.fire(deviceID, evt)
+		}
+	}
+}
+
+// fire 执行一次自动事件
+func (m *AutoEventManager) fire(deviceID string, evt AutoEvent) {
+	m.mutex.RLock()
+	target, ok := m.targets[deviceID]
+	m.mutex.RUnlock()
+	if !ok {
+		return
+	}
+
+	switch evt.Kind {
+	case AutoEventSensorRead:
+		data, err := target.ReadSensorData()
+		if err != nil {
+			log.Printf("❌ 自动事件 %s/%s 读取传感器失败: %v", deviceID, evt.Name, err)
+			return
+		}
+		target.PublishReading(data)
+	case AutoEventExecutePreset:
+		presetName, _ := evt.Params["preset"].(string)
+		if err := target.ExecutePreset(presetName); err != nil {
+			log.Printf("❌ 自动事件 %s/%s 执行预设姿势失败: %v", deviceID, evt.Name, err)
+		}
+	case AutoEventSetFingerPose:
+		pose, err := poseFromParam(evt.Params["pose"])
+		if err != nil {
+			log.Printf("❌ 自动事件 %s/%s 的 pose 参数无效: %v", deviceID, evt.Name, err)
+			return
+		}
+		if err := target.SetFingerPose(pose); err != nil {
+			log.Printf("❌ 自动事件 %s/%s 设置手指姿态失败: %v", deviceID, evt.Name, err)
+		}
+	case AutoEventAnimation:
+		animName, _ := evt.Params["animation"].(string)
+		if err := target.PlayAnimation(animName); err != nil {
+			log.Printf("❌ 自动事件 %s/%s 播放动画 '%s' 失败: %v", deviceID, evt.Name, animName, err)
+		}
+	default:
+		log.Printf("⚠️ 未知的自动事件类型: %s", evt.Kind)
+	}
+}
+
+// poseFromParam 把自动事件 Params 中的 pose 参数转换为 []byte。Params 来自 JSON 解码
+// （无论是 auto_events 配置列表还是 AddAutoEventHandler 的请求体），encoding/json 总是
+// 把数组解码为 []interface{}、把数字解码为 float64，因此不能直接断言为 []byte
+func poseFromParam(raw any) ([]byte, error) {
+	values, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("pose 参数必须是一个数值数组")
+	}
+
+	pose := make([]byte, len(values))
+	for i, v := range values {
+		n, ok := v.(float64)
+		if !ok || n < 0 || n > 255 {
+			return nil, fmt.Errorf("pose[%d] 不是 0-255 范围内的数值", i)
+		}
+		pose[i] = byte(n)
+	}
+	return pose, nil
+}
+
+// StopForDevice 停止指定设备的所有自动事件，但保留事件定义以便之后 RestartForDevice
+func (m *AutoEventManager) StopForDevice(deviceID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	stops, ok := m.stopCh[deviceID]
+	if !ok {
+		return fmt.Errorf("设备 %s 尚未注册到自动事件管理器", deviceID)
+	}
+	for name, stop := range stops {
+		close(stop)
+		delete(stops, name)
+	}
+	return nil
+}
+
+// RestartForDevice 重新启动指定设备的所有已注册自动事件
+func (m *AutoEventManager) RestartForDevice(deviceID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	evts, ok := m.events[deviceID]
+	if !ok {
+		return fmt.Errorf("设备 %s 尚未注册到自动事件管理器", deviceID)
+	}
+	for name, evt := range evts {
+		m.startLocked(deviceID, name, evt)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package device
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Priority 表示指令在 CommandDispatcher 队列中的优先级，数值越大优先级越高
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+)
+
+// BackpressureMode 决定某个优先级队列满时如何处理新入队的指令
+type BackpressureMode int
+
+const (
+	// BackpressureBlock 队列满时阻塞，直到有空位
+	BackpressureBlock BackpressureMode = iota
+	// BackpressureDropOldest 队列满时丢弃队列中最旧的一条，为新指令腾出空间
+	BackpressureDropOldest
+)
+
+// CommandResult 是一次指令执行的结果，通过 future channel 异步返回给调用方
+type CommandResult struct {
+	Err error
+}
+
+// commandJob 是提交给 CommandDispatcher 的一条待执行指令
+type commandJob struct {
+	cmd      Command
+	resultCh chan CommandResult
+}
+
+// CommandDispatcher 是一个按优先级分发的有界指令工作池：指令不再像此前那样独占整段
+// CAN 往返时间的写锁串行执行，而是被放入对应优先级的队列，由固定数量的 worker goroutine
+// 取出并调用 executor 发送，使状态读取和高优先级指令（如紧急复位）不被一帧正在播放的
+// 动画阻塞
+type CommandDispatcher struct {
+	high, normal, low chan commandJob
+	backpressure      map[Priority]BackpressureMode
+	backpressureMutex sync.RWMutex
+	executor          func(Command) error
+	dropCount         int64
+	stopCh            chan struct{}
+	wg                sync.WaitGroup
+}
+
+// NewCommandDispatcher 创建一个调度器，每个优先级队列的容量都是 queueSize；
+// executor 是真正执行一条指令（编码并通过通信层发送）的函数
+func NewCommandDispatcher(queueSize int, executor func(Command) error) *CommandDispatcher {
+	return &CommandDispatcher{
+		high:   make(chan commandJob, queueSize),
+		normal: make(chan commandJob, queueSize),
+		low:    make(chan commandJob, queueSize),
+		backpressure: map[Priority]BackpressureMode{
+			PriorityHigh:   BackpressureBlock,
+			PriorityNormal: BackpressureBlock,
+			PriorityLow:    BackpressureDropOldest,
+		},
+		executor: executor,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// SetBackpressure 覆盖某个优先级队列满时的行为，默认高/普通优先级阻塞、低优先级（动画帧）丢弃最旧的一条
+func (d *CommandDispatcher) SetBackpressure(p Priority, mode BackpressureMode) {
+	d.backpressureMutex.Lock()
+	defer d.backpressureMutex.Unlock()
+	d.backpressure[p] = mode
+}
+
+func (d *CommandDispatcher) modeFor(p Priority) BackpressureMode {
+	d.backpressureMutex.RLock()
+	defer d.backpressureMutex.RUnlock()
+	return d.backpressure[p]
+}
+
+// Start 启动指定数量的 worker goroutine；每个 worker 总是优先处理高优先级队列，
+// 其次普通优先级，最后才轮到低优先级（动画帧）
+func (d *CommandDispatcher) Start(workers int) {
+	for i := 0; i < workers; i++ {
+		d.wg.Add(1)
+		go d.worker()
+	}
+}
+
+func (d *CommandDispatcher) worker() {
+	defer d.wg.Done()
+
+	for {
+		// 优先检查高优先级队列，避免紧急指令被 select 的随机选择延迟
+		select {
+		case <-d.stopCh:
+			return
+		case job := <-d.high:
+			d.run(job)
+			continue
+		default:
+		}
+
+		// 高优先级队列为空时再检查普通优先级队列，使用户指令先于低优先级的动画帧执行
+		select {
+		case <-d.stopCh:
+			return
+		case job := <-d.normal:
+			d.run(job)
+			continue
+		default:
+		}
+
+		select {
+		case <-d.stopCh:
+			return
+		case job := <-d.high:
+			d.run(job)
+		case job := <-d.normal:
+			d.run(job)
+		case job := <-d.low:
+			d.run(job)
+		}
+	}
+}
+
+func (d *CommandDispatcher) run(job commandJob) {
+	err := d.executor(job.cmd)
+	if job.resultCh != nil {
+		job.resultCh <- CommandResult{Err: err}
+		close(job.resultCh)
+	}
+}
+
+// failDropped 向因 BackpressureDropOldest 被挤出队列的指令回复一个失败结果，
+// 避免等待在其 resultCh 上的调用方（如动画引擎的 ExecuteAnimationFrame）永久阻塞
+func (d *CommandDispatcher) failDropped(job commandJob) {
+	if job.resultCh == nil {
+		return
+	}
+	job.resultCh <- CommandResult{Err: fmt.Errorf("指令在队列中等待过久，已被丢弃")}
+	close(job.resultCh)
+}
+
+func (d *CommandDispatcher) queueFor(p Priority) chan commandJob {
+	switch p {
+	case PriorityHigh:
+		return d.high
+	case PriorityLow:
+		return d.low
+	default:
+		return d.normal
+	}
+}
+
+// Enqueue 提交一条指令，返回的 channel 会在指令执行完成后收到唯一一个 CommandResult
+func (d *CommandDispatcher) Enqueue(cmd Command, priority Priority) (<-chan CommandResult, error) {
+	resultCh := make(chan CommandResult, 1)
+	job := commandJob{cmd: cmd, resultCh: resultCh}
+	queue := d.queueFor(priority)
+
+	if d.modeFor(priority) == BackpressureDropOldest {
+		select {
+		case queue <- job:
+			return resultCh, nil
+		default:
+		}
+		select {
+		case dropped := <-queue:
+			atomic.AddInt64(&d.dropCount, 1)
+			d.failDropped(dropped)
+		default:
+		}
+		select {
+		case queue <- job:
+			return resultCh, nil
+		default:
+			return nil, fmt.Errorf("指令队列已满")
+		}
+	}
+
+	queue <- job
+	return resultCh, nil
+}
+
+// QueueDepth 返回当前三个优先级队列中排队指令的总数
+func (d *CommandDispatcher) QueueDepth() int {
+	return len(d.high) + len(d.normal) + len(d.low)
+}
+
+// DropCount 返回因采用 BackpressureDropOldest 策略而被丢弃的指令总数
+func (d *CommandDispatcher) DropCount() int {
+	return int(atomic.LoadInt64(&d.dropCount))
+}
+
+// Stop 停止所有 worker，等待它们退出
+func (d *CommandDispatcher) Stop() {
+	close(d.stopCh)
+	d.wg.Wait()
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"hands/device"
+)
+
+// addAutoEventRequest 是 AddAutoEventHandler 的请求体
+type addAutoEventRequest struct {
+	Name     string         `json:"name"`
+	Kind     string         `json:"kind"`
+	Interval string         `json:"interval"` // time.ParseDuration 格式，如 "100ms"
+	Params   map[string]any `json:"params"`
+}
+
+// AddAutoEventHandler 返回一个用于在运行时为该设备新增自动事件的 HTTP 处理函数
+func (h *L10Hand) AddAutoEventHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "仅支持 POST 方法", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req addAutoEventRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "请求体解析失败: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		interval, err := time.ParseDuration(req.Interval)
+		if err != nil {
+			http.Error(w, "interval 格式错误: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		evt := device.AutoEvent{
+			Name:     req.Name,
+			Kind:     device.AutoEventKind(req.Kind),
+			Interval: interval,
+			Params:   req.Params,
+		}
+
+		if err := h.autoEvents.RegisterEvent(h.id, evt); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := h.autoEvents.RestartForDevice(h.id); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+// RemoveAutoEventHandler 返回一个用于在运行时删除该设备某个自动事件的 HTTP 处理函数，
+// 事件名通过查询参数 `name` 传入
+func (h *L10Hand) RemoveAutoEventHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			http.Error(w, "仅支持 DELETE 方法", http.StatusMethodNotAllowed)
+			return
+		}
+
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			http.Error(w, "缺少 name 查询参数", http.StatusBadRequest)
+			return
+		}
+
+		if err := h.autoEvents.RemoveEvent(h.id, name); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
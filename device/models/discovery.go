@@ -0,0 +1,166 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"hands/communication"
+	"hands/define"
+)
+
+// identifyCmd 是广播在总线上的"识别"帧所使用的 Cmd 值，与业务帧（0x01/0x04 前缀）区分开。
+// 必须取自 0x80 以下（最高位为 0），否则会和 communication.ackFlag 标记的 ACK 帧混淆——
+// IsAck 只看 Cmd 的最高位，0xFE 这种取值会让 startAckListener 把识别帧误判成 ACK
+const identifyCmd byte = 0x7F
+
+// broadcastCanID 是发送识别帧时使用的 CAN ID，约定所有 L10 手都会监听该 ID
+const broadcastCanID uint32 = 0x000
+
+// DiscoveredDevice 描述一次扫描发现的手部设备
+type DiscoveredDevice struct {
+	ID       string
+	HandType define.HandType
+	Firmware string
+	CanID    uint32
+}
+
+// DiscoverL10Hands 在给定的 CAN 接口上广播一帧"识别"帧，并在 window 时间内收集响应，
+// 返回所有响应设备的描述。手型根据响应帧所使用的 CAN ID 推断（CAN ID 即 define.HandType 的取值）。
+func DiscoverL10Hands(ctx context.Context, serviceURL, iface string, window time.Duration) ([]DiscoveredDevice, error) {
+	comm := communication.NewCanBridgeClient(serviceURL)
+
+	identifyFrame := communication.EncodeFrame(communication.Frame{
+		Seq: 0,
+		Cmd: identifyCmd,
+	})
+	broadcast := communication.RawMessage{
+		Interface: iface,
+		ID:        broadcastCanID,
+		Data:      identifyFrame,
+	}
+
+	if err := comm.SendMessage(ctx, broadcast); err != nil {
+		return nil, fmt.Errorf("广播识别帧失败：%w", err)
+	}
+
+	receiver, ok := comm.(communication.FrameReceiver)
+	if !ok {
+		return nil, fmt.Errorf("通信客户端不支持接收响应，无法完成设备发现")
+	}
+
+	listenCtx, cancel := context.WithTimeout(ctx, window)
+	defer cancel()
+
+	seen := make(map[uint32]DiscoveredDevice)
+	for {
+		raw, err := receiver.ReceiveMessage(listenCtx, iface)
+		if err != nil {
+			break // 超时或 ctx 取消，结束本轮扫描
+		}
+
+		frame, err := communication.DecodeFrame(raw.Data)
+		if err != nil || frame.Cmd != identifyCmd {
+			continue
+		}
+
+		if _, exists := seen[raw.ID]; exists {
+			continue
+		}
+		seen[raw.ID] = DiscoveredDevice{
+			ID:       fmt.Sprintf("l10-%x", raw.ID),
+			HandType: define.HandType(raw.ID),
+			Firmware: string(frame.Payload),
+			CanID:    raw.ID,
+		}
+	}
+
+	discovered := make([]DiscoveredDevice, 0, len(seen))
+	for _, d := range seen {
+		discovered = append(discovered, d)
+	}
+	return discovered, nil
+}
+
+// DeviceRegistry 是 DiscoveryLoop 所依赖的最小接口，由维护当前设备集合的组件
+// （通常是 dashboard 的设备管理器）实现
+type DeviceRegistry interface {
+	RegisterDiscovered(d DiscoveredDevice) error
+	MarkDisconnected(id string) error
+	KnownIDs() []string
+}
+
+// DiscoveryLoop 周期性地在指定 CAN 接口上扫描设备，把新出现的手自动注册到 registry，
+// 并把不再响应的已知设备标记为断开，从而支持热插拔
+type DiscoveryLoop struct {
+	serviceURL string
+	iface      string
+	interval   time.Duration
+	window     time.Duration
+	registry   DeviceRegistry
+	stopCh     chan struct{}
+}
+
+// NewDiscoveryLoop 创建一个自动发现循环，interval 是两次扫描之间的间隔，
+// window 是单次扫描等待响应的时长
+func NewDiscoveryLoop(serviceURL, iface string, interval, window time.Duration, registry DeviceRegistry) *DiscoveryLoop {
+	return &DiscoveryLoop{
+		serviceURL: serviceURL,
+		iface:      iface,
+		interval:   interval,
+		window:     window,
+		registry:   registry,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start 启动后台扫描 goroutine，直到 Stop 被调用
+func (l *DiscoveryLoop) Start() {
+	go func() {
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-l.stopCh:
+				return
+			case <-ticker.C:
+				l.scanOnce()
+			}
+		}
+	}()
+}
+
+// scanOnce 执行一轮扫描：注册新发现的设备，并把本轮未响应的已知设备标记为断开
+func (l *DiscoveryLoop) scanOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), l.window+time.Second)
+	defer cancel()
+
+	discovered, err := DiscoverL10Hands(ctx, l.serviceURL, l.iface, l.window)
+	if err != nil {
+		log.Printf("❌ 设备自动发现扫描失败: %v", err)
+		return
+	}
+
+	seen := make(map[string]bool, len(discovered))
+	for _, d := range discovered {
+		seen[d.ID] = true
+		if err := l.registry.RegisterDiscovered(d); err != nil {
+			log.Printf("❌ 注册自动发现的设备 %s 失败: %v", d.ID, err)
+		}
+	}
+
+	for _, id := range l.registry.KnownIDs() {
+		if !seen[id] {
+			if err := l.registry.MarkDisconnected(id); err != nil {
+				log.Printf("❌ 标记设备 %s 断开失败: %v", id, err)
+			}
+		}
+	}
+}
+
+// Stop 停止自动发现循环
+func (l *DiscoveryLoop) Stop() {
+	close(l.stopCh)
+}
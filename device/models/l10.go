@@ -12,6 +12,7 @@ import (
 	"hands/component"
 	"hands/define"
 	"hands/device"
+	_ "hands/protocols/l10can" // 注册 L10 协议驱动
 )
 
 // L10Hand L10 型号手部设备实现
@@ -23,9 +24,22 @@ type L10Hand struct {
 	components      map[device.ComponentType][]device.Component
 	status          device.DeviceStatus
 	mutex           sync.RWMutex
-	canInterface    string                  // CAN 接口名称，如 "can0"
-	animationEngine *device.AnimationEngine // 动画引擎
-	presetManager   *device.PresetManager   // 预设姿势管理器
+	canInterface    string                    // CAN 接口名称，如 "can0"
+	animationEngine *device.AnimationEngine   // 动画引擎
+	presetManager   *device.PresetManager     // 预设姿势管理器
+	autoEvents      *device.AutoEventManager  // 自动事件管理器（周期性传感器轮询/预设/姿态）
+	driver          device.ProtocolDriver     // 协议驱动，负责指令与 CAN 帧之间的编解码
+	dispatcher      *device.CommandDispatcher // 按优先级分发指令的有界工作池
+	broker          *device.StreamBroker      // 传感器/指令回显的流式数据 broker
+	streamCancel    context.CancelFunc        // 停止流式数据采集（推送或轮询）
+
+	// --- 可靠投递（帧 + 序列号 + ACK 重试）相关状态 ---
+	reliableSeq  byte                   // 下一次可靠发送使用的序列号
+	pendingAcks  map[byte]chan struct{} // 序列号 -> 等待 ACK 的通知 channel
+	pendingMutex sync.Mutex             // 保护 pendingAcks
+	ackRetries   int                    // 未收到 ACK 时的最大重试次数
+	ackTimeout   time.Duration          // 单次发送等待 ACK 的超时时间
+	sendMutex    sync.Mutex             // 串行化一帧拆分出的多个物理分片的发送，避免多个 worker 的分片在总线上交错
 }
 
 // 在 base 基础上进行 ±delta 的扰动，范围限制在 [0, 255]
@@ -81,8 +95,44 @@ func NewL10Hand(config map[string]any) (device.Device, error) {
 		},
 	}
 
-	// 初始化动画引擎，将 hand 自身作为 PoseExecutor
-	hand.animationEngine = device.NewAnimationEngine(hand)
+	// 创建协议驱动，负责将通用指令编解码为 L10 特定的 CAN 帧
+	driver, err := device.NewProtocolDriver("L10", map[string]any{
+		"can_interface": canInterface,
+		"hand_type":     handType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建协议驱动失败：%w", err)
+	}
+	hand.driver = driver
+
+	// 可靠发送的重试次数与超时时间，均支持通过配置覆盖默认值
+	hand.ackRetries = 3
+	if v, ok := config["ack_retries"].(int); ok {
+		hand.ackRetries = v
+	}
+	hand.ackTimeout = 500 * time.Millisecond
+	if v, ok := config["ack_timeout"].(time.Duration); ok {
+		hand.ackTimeout = v
+	}
+	hand.pendingAcks = make(map[byte]chan struct{})
+	hand.startAckListener()
+
+	// 创建指令调度器：固定数量的 worker 按优先级从队列中取出指令并发送，
+	// 使高优先级指令（如 ResetPose）不被正在进行的低优先级动画帧阻塞
+	commandWorkers := 2
+	if v, ok := config["command_workers"].(int); ok {
+		commandWorkers = v
+	}
+	commandQueueSize := 32
+	if v, ok := config["command_queue_size"].(int); ok {
+		commandQueueSize = v
+	}
+	hand.dispatcher = device.NewCommandDispatcher(commandQueueSize, hand.executeCommandDirect)
+	hand.dispatcher.Start(commandWorkers)
+
+	// 初始化动画引擎，使用 animationPoseExecutor 而非 hand 自身作为 PoseExecutor，
+	// 使动画的每一帧都以 PriorityLow 提交，可以被用户下发的 ResetPose 等高优先级指令抢占
+	hand.animationEngine = device.NewAnimationEngine(&animationPoseExecutor{hand: hand})
 
 	// 注册默认动画
 	hand.animationEngine.Register(NewL10WaveAnimation())
@@ -101,10 +151,68 @@ func NewL10Hand(config map[string]any) (device.Device, error) {
 		return nil, fmt.Errorf("初始化组件失败：%w", err)
 	}
 
+	// 初始化流式数据 broker，并根据传感器组件是否支持主动推送选择推送或轮询兜底
+	hand.broker = device.NewStreamBroker(id)
+	streamPollRate := 200 * time.Millisecond
+	if v, ok := config["stream_poll_rate"].(time.Duration); ok {
+		streamPollRate = v
+	}
+	hand.startStreaming(streamPollRate)
+
+	// 初始化自动事件管理器，并根据配置中的 auto_events 列表注册声明式的周期性任务
+	hand.autoEvents = device.NewAutoEventManager()
+	hand.autoEvents.RegisterDevice(hand)
+	if err := hand.registerAutoEventsFromConfig(config); err != nil {
+		return nil, fmt.Errorf("注册自动事件失败：%w", err)
+	}
+	if err := hand.autoEvents.StartAutoEvents(); err != nil {
+		return nil, fmt.Errorf("启动自动事件失败：%w", err)
+	}
+
 	log.Printf("✅ 设备 L10 (%s, %s) 创建成功", id, handType.String())
 	return hand, nil
 }
 
+// registerAutoEventsFromConfig 解析配置中的 auto_events 列表并注册到自动事件管理器。
+// 每一项形如 {"name": "tactile-poll", "kind": "SensorRead", "interval": "100ms", "params": {...}}
+func (h *L10Hand) registerAutoEventsFromConfig(config map[string]any) error {
+	raw, ok := config["auto_events"].([]any)
+	if !ok {
+		return nil
+	}
+
+	for _, item := range raw {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			return fmt.Errorf("auto_events 中存在格式错误的条目")
+		}
+
+		name, _ := entry["name"].(string)
+		kind, _ := entry["kind"].(string)
+		intervalStr, _ := entry["interval"].(string)
+		params, _ := entry["params"].(map[string]any)
+
+		interval, err := time.ParseDuration(intervalStr)
+		if err != nil {
+			return fmt.Errorf("自动事件 %s 的 interval 格式错误：%w", name, err)
+		}
+
+		evt := device.AutoEvent{
+			Name:     name,
+			Kind:     device.AutoEventKind(kind),
+			Interval: interval,
+			Params:   params,
+		}
+		if err := h.autoEvents.RegisterEvent(h.id, evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAutoEventManager 获取自动事件管理器
+func (h *L10Hand) GetAutoEventManager() *device.AutoEventManager { return h.autoEvents }
+
 // GetHandType 获取设备手型
 func (h *L10Hand) GetHandType() define.HandType {
 	h.mutex.RLock()
@@ -129,8 +237,39 @@ func (h *L10Hand) GetAnimationEngine() *device.AnimationEngine {
 	return h.animationEngine
 }
 
+// PlayAnimation 按名称播放一个已注册的动画 (实现 AutoEventTarget)，
+// 供 AutoEventManager 声明式地周期性触发已注册的动画（如 wave/sway）
+func (h *L10Hand) PlayAnimation(name string) error {
+	return h.animationEngine.Play(name)
+}
+
+// animationPoseExecutor 是动画引擎实际持有的 PoseExecutor，把每一帧都以 PriorityLow
+// 提交给 hand 的指令调度器，而不是像手动调用 SetFingerPose/SetPalmPose 那样使用
+// PriorityNormal，使正在播放的动画可以被用户下发的高优先级指令（如 ResetPose）抢占，
+// 队列积压时也能按 BackpressureDropOldest 丢弃过时的帧而不是阻塞动画循环
+type animationPoseExecutor struct {
+	hand *L10Hand
+}
+
+func (a *animationPoseExecutor) SetFingerPose(pose []byte) error {
+	return a.hand.setFingerPose(pose, device.PriorityLow)
+}
+
+func (a *animationPoseExecutor) SetPalmPose(pose []byte) error {
+	return a.hand.setPalmPose(pose, device.PriorityLow)
+}
+
+func (a *animationPoseExecutor) ResetPose() error {
+	return a.hand.ResetPose()
+}
+
 // SetFingerPose 设置手指姿态 (实现 PoseExecutor)
 func (h *L10Hand) SetFingerPose(pose []byte) error {
+	return h.setFingerPose(pose, device.PriorityNormal)
+}
+
+// setFingerPose 是 SetFingerPose 的内部实现，允许调用方（如 ResetPose）指定优先级
+func (h *L10Hand) setFingerPose(pose []byte, priority device.Priority) error {
 	if len(pose) != 6 {
 		return fmt.Errorf("无效的手指姿态数据长度，需要 6 个字节")
 	}
@@ -145,7 +284,7 @@ func (h *L10Hand) SetFingerPose(pose []byte) error {
 	cmd := device.NewFingerPoseCommand(perturbedPose)
 
 	// 执行指令
-	err := h.ExecuteCommand(cmd)
+	err := h.executeCommandAwait(cmd, priority)
 	if err == nil {
 		log.Printf("✅ %s (%s) 手指动作已发送: [%X %X %X %X %X %X]",
 			h.id, h.GetHandType().String(), perturbedPose[0], perturbedPose[1], perturbedPose[2],
@@ -156,6 +295,11 @@ func (h *L10Hand) SetFingerPose(pose []byte) error {
 
 // SetPalmPose 设置手掌姿态 (实现 PoseExecutor)
 func (h *L10Hand) SetPalmPose(pose []byte) error {
+	return h.setPalmPose(pose, device.PriorityNormal)
+}
+
+// setPalmPose 是 SetPalmPose 的内部实现，允许调用方（如 ResetPose）指定优先级
+func (h *L10Hand) setPalmPose(pose []byte, priority device.Priority) error {
 	if len(pose) != 4 {
 		return fmt.Errorf("无效的手掌姿态数据长度，需要 4 个字节")
 	}
@@ -170,7 +314,7 @@ func (h *L10Hand) SetPalmPose(pose []byte) error {
 	cmd := device.NewPalmPoseCommand(perturbedPose)
 
 	// 执行指令
-	err := h.ExecuteCommand(cmd)
+	err := h.executeCommandAwait(cmd, priority)
 	if err == nil {
 		log.Printf("✅ %s (%s) 掌部姿态已发送: [%X %X %X %X]",
 			h.id, h.GetHandType().String(), perturbedPose[0], perturbedPose[1], perturbedPose[2], perturbedPose[3])
@@ -179,17 +323,18 @@ func (h *L10Hand) SetPalmPose(pose []byte) error {
 }
 
 // ResetPose 重置到默认姿态 (实现 PoseExecutor)
+// 使用 PriorityHigh 提交，使复位可以抢占队列中排队的普通指令或正在进行的动画帧
 func (h *L10Hand) ResetPose() error {
 	log.Printf("🔄 正在重置设备 %s (%s) 到默认姿态...", h.id, h.GetHandType().String())
 	defaultFingerPose := []byte{64, 64, 64, 64, 64, 64} // 0x40 - 半开
 	defaultPalmPose := []byte{128, 128, 128, 128}       // 0x80 - 居中
 
-	if err := h.SetFingerPose(defaultFingerPose); err != nil {
+	if err := h.setFingerPose(defaultFingerPose, device.PriorityHigh); err != nil {
 		log.Printf("❌ %s 重置手指姿势失败: %v", h.id, err)
 		return err
 	}
 	time.Sleep(20 * time.Millisecond) // 短暂延时
-	if err := h.SetPalmPose(defaultPalmPose); err != nil {
+	if err := h.setPalmPose(defaultPalmPose, device.PriorityHigh); err != nil {
 		log.Printf("❌ %s 重置掌部姿势失败: %v", h.id, err)
 		return err
 	}
@@ -197,70 +342,210 @@ func (h *L10Hand) ResetPose() error {
 	return nil
 }
 
-// commandToRawMessageUnsafe 将通用指令转换为 L10 特定的 CAN 消息（不加锁版本）
-// 注意：此方法不是线程安全的，只应在已获取适当锁的情况下调用
-func (h *L10Hand) commandToRawMessageUnsafe(cmd device.Command) (communication.RawMessage, error) {
-	var data []byte
-	canID := uint32(h.handType)
+// ExecuteCommand 执行一个通用指令：提交到普通优先级队列并同步等待结果，
+// 以保持与现有调用方（SetFingerPose、SetPalmPose、ExecutePreset 等）一致的同步接口
+func (h *L10Hand) ExecuteCommand(cmd device.Command) error {
+	return h.executeCommandAwait(cmd, device.PriorityNormal)
+}
 
-	switch cmd.Type() {
-	case "SetFingerPose":
-		// 添加 0x01 前缀
-		data = append([]byte{0x01}, cmd.Payload()...)
-		if len(data) > 8 { // CAN 消息数据长度限制
-			return communication.RawMessage{}, fmt.Errorf("手指姿态数据过长")
-		}
-	case "SetPalmPose":
-		// 添加 0x04 前缀
-		data = append([]byte{0x04}, cmd.Payload()...)
-		if len(data) > 8 { // CAN 消息数据长度限制
-			return communication.RawMessage{}, fmt.Errorf("手掌姿态数据过长")
-		}
-	default:
-		return communication.RawMessage{}, fmt.Errorf("L10 不支持的指令类型: %s", cmd.Type())
+// executeCommandAwait 将指令提交给 CommandDispatcher 并同步等待其执行结果
+func (h *L10Hand) executeCommandAwait(cmd device.Command, priority device.Priority) error {
+	resultCh, err := h.dispatcher.Enqueue(cmd, priority)
+	if err != nil {
+		return fmt.Errorf("指令入队失败：%w", err)
 	}
-
-	return communication.RawMessage{
-		Interface: h.canInterface,
-		ID:        canID,
-		Data:      data,
-	}, nil
+	result := <-resultCh
+	return result.Err
 }
 
-// ExecuteCommand 执行一个通用指令
-func (h *L10Hand) ExecuteCommand(cmd device.Command) error {
-	h.mutex.Lock() // 使用写锁，因为会更新状态
-	defer h.mutex.Unlock()
-
-	if !h.status.IsConnected || !h.status.IsActive {
+// executeCommandDirect 真正执行一条指令：编码并通过可靠发送机制发出，由 CommandDispatcher
+// 的 worker goroutine 调用，多个 worker 可能并发执行到这里。写锁只在读写设备状态字段时
+// 短暂持有，编码与可靠发送（可能耗时数秒）期间不持有锁，使 GetStatus 等只读调用不会被
+// 一次慢速的 CAN 往返阻塞，多个 worker 也能真正并发地收发
+func (h *L10Hand) executeCommandDirect(cmd device.Command) error {
+	h.mutex.RLock()
+	connected := h.status.IsConnected && h.status.IsActive
+	h.mutex.RUnlock()
+	if !connected {
 		return fmt.Errorf("设备 %s 未连接或未激活", h.id)
 	}
 
-	// 转换指令为 CAN 消息（使用不加锁版本，因为已经在写锁保护下）
-	rawMsg, err := h.commandToRawMessageUnsafe(cmd)
+	// 将指令交给协议驱动编码为 CAN 帧，L10Hand 自身不再关心具体的帧格式
+	rawMsgs, err := h.driver.Encode(cmd)
 	if err != nil {
-		h.status.ErrorCount++
-		h.status.LastError = err.Error()
+		h.recordError(err)
 		return fmt.Errorf("转换指令失败：%w", err)
 	}
 
-	// 创建带有超时的 context，设置 3 秒超时
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	// 超时时长按配置的 ackTimeout/ackRetries 算出，覆盖完整的重试预算，再加一点余量
+	// 覆盖编码/排队耗时；固定的超时常量会在 ackTimeout 配置得比默认值更大时，
+	// 让外层 ctx 先于 reliableSend 内部的重试循环超时，导致实际重试次数少于配置值
+	ctx, cancel := context.WithTimeout(context.Background(), h.sendTimeout())
 	defer cancel()
 
-	// 发送到 can-bridge 服务
-	if err := h.communicator.SendMessage(ctx, rawMsg); err != nil {
-		h.status.ErrorCount++
-		h.status.LastError = err.Error()
-		log.Printf("❌ %s (%s) 发送指令失败: %v (ID: 0x%X, Data: %X)", h.id, h.handType.String(), err, rawMsg.ID, rawMsg.Data)
-		return fmt.Errorf("发送指令失败：%w", err)
+	// 以可靠发送的方式送出，等待对端 ACK，超时按配置的次数重试
+	for _, rawMsg := range rawMsgs {
+		if err := h.reliableSend(ctx, rawMsg); err != nil {
+			h.recordError(err)
+			log.Printf("❌ %s (%s) 发送指令失败: %v (ID: 0x%X, Data: %X)", h.id, h.handType.String(), err, rawMsg.ID, rawMsg.Data)
+			return fmt.Errorf("发送指令失败：%w", err)
+		}
 	}
 
+	h.mutex.Lock()
 	h.status.LastUpdate = time.Now()
+	h.status.QueueDepth = h.dispatcher.QueueDepth()
+	h.status.DropCount = h.dispatcher.DropCount()
+	lastUpdate := h.status.LastUpdate
+	h.mutex.Unlock()
+
+	// 将本次执行作为指令回显发布到流式数据，使订阅者可以把 ACK/遥测与下发的指令关联起来
+	h.broker.Publish(device.Reading{
+		DeviceID:  h.id,
+		Kind:      device.ReadingCommand,
+		Timestamp: lastUpdate,
+		Command:   cmd.Type(),
+	})
 
 	return nil
 }
 
+// sendTimeout 计算 executeCommandDirect 外层 ctx 的超时时长：ackTimeout 乘以完整的重试
+// 次数（首次发送 + ackRetries 次重试），再加 1 秒余量覆盖发送本身的耗时
+func (h *L10Hand) sendTimeout() time.Duration {
+	return h.ackTimeout*time.Duration(h.ackRetries+1) + time.Second
+}
+
+// recordError 在写锁保护下记录一次指令执行错误，供 executeCommandDirect 在编码或
+// 可靠发送失败时调用
+func (h *L10Hand) recordError(err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.status.ErrorCount++
+	h.status.LastError = err.Error()
+}
+
+// nextSeq 分配下一个帧序列号，多个 worker 可能并发调用，由 pendingMutex 保护
+func (h *L10Hand) nextSeq() byte {
+	h.pendingMutex.Lock()
+	defer h.pendingMutex.Unlock()
+	h.reliableSeq++
+	return h.reliableSeq
+}
+
+// reliableSend 将一次原始 CAN 发送封装为带序列号的帧，并等待对端 ACK；超时未收到 ACK
+// 时会按 ackRetries 重试，重试次数与本次耗时记录到 DeviceStatus。发送与等待 ACK 期间不
+// 持有 h.mutex，只在实际写入 DeviceStatus 字段时短暂加锁，可能与其它 worker 并发执行。
+func (h *L10Hand) reliableSend(ctx context.Context, rawMsg communication.RawMessage) error {
+	start := time.Now()
+	seq := h.nextSeq()
+
+	frame := communication.Frame{Seq: seq, Cmd: rawMsg.Data[0], Payload: rawMsg.Data[1:]}
+	framedBytes := communication.EncodeFrame(frame)
+	// 帧头尾开销（STX、seq、cmd、len、校验和）可能使编码后的长度超过 CAN 总线单帧 8 字节
+	// 的数据域限制，因此按该限制切分为多条物理消息依次发出，对端按到达顺序拼接即可还原
+	chunks := communication.FragmentFrame(framedBytes, communication.MaxCanFrameLen)
+
+	ackCh := make(chan struct{}, 1)
+	h.pendingMutex.Lock()
+	h.pendingAcks[seq] = ackCh
+	h.pendingMutex.Unlock()
+	defer func() {
+		h.pendingMutex.Lock()
+		delete(h.pendingAcks, seq)
+		h.pendingMutex.Unlock()
+	}()
+
+	var lastErr error
+	for attempt := 0; attempt <= h.ackRetries; attempt++ {
+		if attempt > 0 {
+			h.mutex.Lock()
+			h.status.RetryCount++
+			h.mutex.Unlock()
+		}
+
+		if err := h.sendChunks(ctx, rawMsg, chunks); err != nil {
+			lastErr = err
+			continue
+		}
+
+		select {
+		case <-ackCh:
+			h.mutex.Lock()
+			h.status.LastCommandLatency = time.Since(start)
+			h.mutex.Unlock()
+			return nil
+		case <-time.After(h.ackTimeout):
+			lastErr = fmt.Errorf("等待序列号 %d 的 ACK 超时", seq)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return fmt.Errorf("可靠发送失败（已重试 %d 次）：%w", h.ackRetries, lastErr)
+}
+
+// sendChunks 依次发送一帧拆分出的各个分片，CAN ID 与接口沿用原始消息，仅替换 Data。
+// 持有 sendMutex 确保同一设备上这几条物理消息背靠背发出，不会被另一个并发 worker
+// 正在发送的另一帧的分片插在中间——否则接收端按到达顺序拼接出的就是被打乱的数据。
+func (h *L10Hand) sendChunks(ctx context.Context, rawMsg communication.RawMessage, chunks [][]byte) error {
+	h.sendMutex.Lock()
+	defer h.sendMutex.Unlock()
+
+	for _, chunk := range chunks {
+		chunkMsg := rawMsg
+		chunkMsg.Data = chunk
+		if err := h.communicator.SendMessage(ctx, chunkMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ackListenerBackoff 是 ReceiveMessage 连续出错时，重试前等待的时间，避免忙循环
+const ackListenerBackoff = 100 * time.Millisecond
+
+// startAckListener 在通信层支持接收帧时启动后台监听，将收到的 ACK 帧派发给等待中的 reliableSend；
+// 不支持 FrameReceiver 的 Communicator 实现会被跳过，此时可靠发送退化为只按超时重试。
+// ReceiveMessage 出错（如一次瞬时的连接抖动）时记录错误并短暂退避后继续监听，而不是退出
+// 循环——否则此后设备上所有可靠发送都会退化为每次都超时重试直至失败。
+func (h *L10Hand) startAckListener() {
+	receiver, ok := h.communicator.(communication.FrameReceiver)
+	if !ok {
+		return
+	}
+
+	go func() {
+		for {
+			raw, err := receiver.ReceiveMessage(context.Background(), h.canInterface)
+			if err != nil {
+				log.Printf("❌ %s 接收帧失败，%v 后重试: %v", h.id, ackListenerBackoff, err)
+				time.Sleep(ackListenerBackoff)
+				continue
+			}
+
+			frame, err := communication.DecodeFrame(raw.Data)
+			if err != nil {
+				h.mutex.Lock()
+				h.status.ErrorCount++
+				h.mutex.Unlock()
+				continue
+			}
+			if !communication.IsAck(frame) {
+				continue
+			}
+
+			h.pendingMutex.Lock()
+			if ch, ok := h.pendingAcks[frame.Seq]; ok {
+				select {
+				case ch <- struct{}{}:
+				default:
+				}
+			}
+			h.pendingMutex.Unlock()
+		}
+	}()
+}
+
 func (h *L10Hand) initializeComponents(_ map[string]any) error {
 	// 初始化传感器组件
 	defaultSensor := component.NewSensorData(h.canInterface)
@@ -270,6 +555,49 @@ func (h *L10Hand) initializeComponents(_ map[string]any) error {
 	return nil
 }
 
+// Subscribe 订阅该设备的传感器读数、指令回显与错误事件流，使上层（如 dashboard）
+// 可以通过 WebSocket 等方式实时渲染，而不必轮询 ReadSensorData
+func (h *L10Hand) Subscribe() (<-chan device.Reading, device.CancelFunc) {
+	return h.broker.Subscribe()
+}
+
+// PublishReading 把一条传感器读数发布到该设备的流式数据 broker (实现 AutoEventTarget)，
+// 使 SensorRead 自动事件的结果和主动推送/轮询产生的读数一样，经由同一条 Subscribe 流
+// 到达 dashboard，而不需要一套独立的订阅机制
+func (h *L10Hand) PublishReading(data device.SensorData) {
+	h.broker.Publish(device.Reading{
+		DeviceID:  h.id,
+		Kind:      device.ReadingSensor,
+		Timestamp: time.Now(),
+		Sensor:    data,
+	})
+}
+
+// startStreaming 启动该设备的流式数据采集：如果默认传感器组件实现了 component.StreamingSensor，
+// 直接转发其推送的数据；否则按 rate 轮询 ReadSensorData 兜底
+func (h *L10Hand) startStreaming(rate time.Duration) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.streamCancel = cancel
+
+	for _, comp := range h.components[device.SensorComponent] {
+		if streaming, ok := comp.(component.StreamingSensor); ok {
+			go func(streaming component.StreamingSensor) {
+				for data := range streaming.Stream(ctx) {
+					h.broker.Publish(device.Reading{
+						DeviceID:  h.id,
+						Kind:      device.ReadingSensor,
+						Timestamp: time.Now(),
+						Sensor:    data,
+					})
+				}
+			}(streaming)
+			return
+		}
+	}
+
+	go h.broker.PollReadData(ctx, rate, h.ReadSensorData)
+}
+
 func (h *L10Hand) GetID() string {
 	return h.id
 }
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"hands/communication"
+	"hands/define"
+	"hands/device"
+)
+
+// fakeDriver 是测试用的协议驱动，只记录被编码过的指令而不关心具体的帧格式，
+// 用于验证 L10Hand 在更换协议驱动后仍能协议无关地工作
+type fakeDriver struct {
+	mu      sync.Mutex
+	encoded []device.Command
+}
+
+func (f *fakeDriver) Encode(cmd device.Command) ([]communication.RawMessage, error) {
+	f.mu.Lock()
+	f.encoded = append(f.encoded, cmd)
+	f.mu.Unlock()
+	return []communication.RawMessage{{
+		Interface: "fake0",
+		ID:        1,
+		Data:      append([]byte{0xAA}, cmd.Payload()...),
+	}}, nil
+}
+
+func (f *fakeDriver) Decode(msg communication.RawMessage) (device.Event, error) {
+	return device.Event{Type: "fake", Payload: msg.Data}, nil
+}
+
+func (f *fakeDriver) Capabilities() []string {
+	return []string{"SetFingerPose", "SetPalmPose"}
+}
+
+func (f *fakeDriver) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.encoded)
+}
+
+// fakeCommunicator 是测试用的通信客户端：不依赖真实的 CAN 总线，而是在收到发送的
+// 帧分片拼出一个完整帧后，立即回送一条对应的 ACK，模拟对端设备的即时确认
+type fakeCommunicator struct {
+	mu      sync.Mutex
+	sent    []communication.RawMessage
+	partial []byte
+	acks    chan communication.RawMessage
+}
+
+func newFakeCommunicator() *fakeCommunicator {
+	return &fakeCommunicator{acks: make(chan communication.RawMessage, 8)}
+}
+
+func (f *fakeCommunicator) SendMessage(_ context.Context, msg communication.RawMessage) error {
+	f.mu.Lock()
+	f.sent = append(f.sent, msg)
+	f.partial = append(f.partial, msg.Data...)
+	frame, err := communication.DecodeFrame(f.partial)
+	if err != nil {
+		f.mu.Unlock()
+		return nil
+	}
+	f.partial = nil
+	ack := communication.EncodeFrame(communication.AckFor(frame.Seq, frame.Cmd))
+	f.mu.Unlock()
+
+	f.acks <- communication.RawMessage{Interface: msg.Interface, ID: msg.ID, Data: ack}
+	return nil
+}
+
+func (f *fakeCommunicator) ReceiveMessage(ctx context.Context, _ string) (communication.RawMessage, error) {
+	select {
+	case msg := <-f.acks:
+		return msg, nil
+	case <-ctx.Done():
+		return communication.RawMessage{}, ctx.Err()
+	}
+}
+
+func (f *fakeCommunicator) GetAllInterfaceStatuses() (map[string]bool, error) {
+	return map[string]bool{"fake0": true}, nil
+}
+
+// newTestHand 手动构造一个绕开驱动注册表、使用 fakeDriver/fakeCommunicator 的 L10Hand，
+// 用于验证 ExecuteCommand、预设姿势和动画在更换协议驱动后依然可以正常工作
+func newTestHand(t *testing.T) (*L10Hand, *fakeDriver) {
+	t.Helper()
+
+	driver := &fakeDriver{}
+	comm := newFakeCommunicator()
+
+	hand := &L10Hand{
+		id:           "test-hand",
+		model:        "L10",
+		handType:     define.HAND_TYPE_RIGHT,
+		communicator: comm,
+		driver:       driver,
+		components:   make(map[device.ComponentType][]device.Component),
+		canInterface: "fake0",
+		status: device.DeviceStatus{
+			IsConnected: true,
+			IsActive:    true,
+		},
+		ackRetries:  0,
+		ackTimeout:  50 * time.Millisecond,
+		pendingAcks: make(map[byte]chan struct{}),
+		broker:      device.NewStreamBroker("test-hand"),
+	}
+	hand.startAckListener()
+
+	hand.dispatcher = device.NewCommandDispatcher(8, hand.executeCommandDirect)
+	hand.dispatcher.Start(1)
+	t.Cleanup(hand.dispatcher.Stop)
+
+	hand.animationEngine = device.NewAnimationEngine(&animationPoseExecutor{hand: hand})
+	hand.animationEngine.Register(NewL10WaveAnimation())
+	hand.animationEngine.Register(NewL10SwayAnimation())
+
+	hand.presetManager = device.NewPresetManager()
+	for _, preset := range GetL10Presets() {
+		hand.presetManager.RegisterPreset(preset)
+	}
+
+	return hand, driver
+}
+
+func TestExecuteCommandWorksWithFakeDriver(t *testing.T) {
+	hand, driver := newTestHand(t)
+
+	cmd := device.NewFingerPoseCommand([]byte{1, 2, 3, 4, 5, 6})
+	if err := hand.ExecuteCommand(cmd); err != nil {
+		t.Fatalf("ExecuteCommand 失败：%v", err)
+	}
+	if got := driver.callCount(); got != 1 {
+		t.Fatalf("期望驱动的 Encode 被调用 1 次，实际 %d 次", got)
+	}
+}
+
+func TestExecutePresetWorksWithFakeDriver(t *testing.T) {
+	hand, _ := newTestHand(t)
+
+	presets := hand.GetSupportedPresets()
+	if len(presets) == 0 {
+		t.Fatal("没有可用的预设姿势")
+	}
+	if err := hand.ExecutePreset(presets[0]); err != nil {
+		t.Fatalf("ExecutePreset 失败：%v", err)
+	}
+}
+
+func TestAnimationPlaysWithFakeDriver(t *testing.T) {
+	hand, _ := newTestHand(t)
+
+	if err := hand.PlayAnimation("wave"); err != nil {
+		t.Fatalf("播放动画失败：%v", err)
+	}
+}
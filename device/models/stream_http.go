@@ -0,0 +1,130 @@
+package models
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// websocketGUID 是 RFC 6455 规定的、用于计算 Sec-WebSocket-Accept 的固定 GUID
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// wsAccept 按 RFC 6455 第 1.3 节计算握手响应中的 Sec-WebSocket-Accept 值
+func wsAccept(key string) string {
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// writeWSTextFrame 向连接写入一个未掩码的服务端文本帧（FIN=1，opcode=0x1），
+// 服务端发往客户端的帧按协议不需要掩码
+func writeWSTextFrame(w *bufio.Writer, payload []byte) error {
+	if err := w.WriteByte(0x81); err != nil {
+		return err
+	}
+
+	n := len(payload)
+	switch {
+	case n < 126:
+		if err := w.WriteByte(byte(n)); err != nil {
+			return err
+		}
+	case n <= 0xFFFF:
+		if err := w.WriteByte(126); err != nil {
+			return err
+		}
+		if err := w.WriteByte(byte(n >> 8)); err != nil {
+			return err
+		}
+		if err := w.WriteByte(byte(n)); err != nil {
+			return err
+		}
+	default:
+		if err := w.WriteByte(127); err != nil {
+			return err
+		}
+		for shift := 56; shift >= 0; shift -= 8 {
+			if err := w.WriteByte(byte(n >> shift)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := w.Write(payload); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// StreamHandler 返回一个通过 WebSocket 推送设备 Reading 流的 HTTP 处理函数，让 dashboard
+// 可以实时渲染传感器读数而不必轮询。握手按 RFC 6455 手工实现而不依赖第三方库：遥测只需要
+// 服务端单向推送，因此收到的客户端帧（含关闭帧）不解析，仅以连接读取出错/EOF 作为断开信号。
+func (h *L10Hand) StreamHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Sec-WebSocket-Key")
+		if key == "" || !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			http.Error(w, "需要 WebSocket 升级请求", http.StatusBadRequest)
+			return
+		}
+
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			http.Error(w, "当前响应不支持 WebSocket 升级", http.StatusInternalServerError)
+			return
+		}
+		conn, rw, err := hijacker.Hijack()
+		if err != nil {
+			http.Error(w, "WebSocket 升级失败: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer conn.Close()
+
+		response := "HTTP/1.1 101 Switching Protocols\r\n" +
+			"Upgrade: websocket\r\n" +
+			"Connection: Upgrade\r\n" +
+			"Sec-WebSocket-Accept: " + wsAccept(key) + "\r\n\r\n"
+		if _, err := rw.WriteString(response); err != nil {
+			return
+		}
+		if err := rw.Flush(); err != nil {
+			return
+		}
+
+		readings, cancel := h.Subscribe()
+		defer cancel()
+
+		// 不解析客户端发来的帧（包括关闭帧），只用读取是否出错来判断连接是否已断开
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			discard := make([]byte, 512)
+			for {
+				if _, err := rw.Read(discard); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-closed:
+				return
+			case <-r.Context().Done():
+				return
+			case reading, ok := <-readings:
+				if !ok {
+					return
+				}
+				payload, err := json.Marshal(reading)
+				if err != nil {
+					continue
+				}
+				if err := writeWSTextFrame(rw.Writer, payload); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package device
+
+import (
+	"fmt"
+	"sync"
+
+	"hands/communication"
+)
+
+// Event 表示协议驱动从原始 CAN 帧中解析出来的设备事件（如状态回显、错误上报）
+type Event struct {
+	Type    string
+	Payload []byte
+}
+
+// ProtocolDriver 将设备无关的 Command 编解码为具体协议（如 L10 的 CAN 帧）上的 RawMessage，
+// 使设备实现（如 L10Hand）不再与某一种总线协议的帧格式耦合，从而可以在不重写连接/状态/
+// 动画引擎等通用机制的前提下支持新的手型或总线协议
+type ProtocolDriver interface {
+	// Encode 将一条通用指令编码为可以直接通过 Communicator 发送的原始消息
+	Encode(cmd Command) ([]communication.RawMessage, error)
+	// Decode 将接收到的原始消息解析为设备事件
+	Decode(msg communication.RawMessage) (Event, error)
+	// Capabilities 返回该驱动支持的指令类型列表
+	Capabilities() []string
+}
+
+// ProtocolDriverFactory 根据设备配置参数构造一个 ProtocolDriver 实例
+type ProtocolDriverFactory func(params map[string]any) (ProtocolDriver, error)
+
+var (
+	driverRegistryMutex sync.RWMutex
+	driverRegistry      = make(map[string]ProtocolDriverFactory)
+)
+
+// RegisterProtocolDriver 以型号名注册一个协议驱动工厂，通常在驱动包的 init() 中调用
+func RegisterProtocolDriver(model string, factory ProtocolDriverFactory) {
+	driverRegistryMutex.Lock()
+	defer driverRegistryMutex.Unlock()
+	driverRegistry[model] = factory
+}
+
+// NewProtocolDriver 按型号名从注册表中查找工厂并构造协议驱动
+func NewProtocolDriver(model string, params map[string]any) (ProtocolDriver, error) {
+	driverRegistryMutex.RLock()
+	factory, ok := driverRegistry[model]
+	driverRegistryMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("未注册型号 %s 的协议驱动", model)
+	}
+	return factory(params)
+}
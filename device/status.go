@@ -0,0 +1,22 @@
+package device
+
+import "time"
+
+// DeviceStatus 描述设备当前的运行状态，由各设备实现在执行指令、连接状态变化时更新
+type DeviceStatus struct {
+	IsConnected bool
+	IsActive    bool
+	LastUpdate  time.Time
+	ErrorCount  int
+	LastError   string
+
+	// LastCommandLatency 记录最近一次可靠发送（等待 ACK）所花费的时间
+	LastCommandLatency time.Duration
+	// RetryCount 记录可靠发送累计的重试次数
+	RetryCount int
+
+	// QueueDepth 记录 CommandDispatcher 三个优先级队列中排队指令的总数
+	QueueDepth int
+	// DropCount 记录因队列已满而被丢弃的指令总数（仅低优先级队列会丢弃）
+	DropCount int
+}
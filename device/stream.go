@@ -0,0 +1,104 @@
+package device
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ReadingKind 标识一条流式数据的来源类型
+type ReadingKind string
+
+const (
+	ReadingSensor  ReadingKind = "sensor"
+	ReadingCommand ReadingKind = "command"
+	ReadingError   ReadingKind = "error"
+)
+
+// Reading 是经 StreamBroker 分发给订阅者的一条带时间戳的数据：可能是传感器读数、
+// 一次指令的执行回显，或者一条错误事件
+type Reading struct {
+	DeviceID  string
+	Kind      ReadingKind
+	Timestamp time.Time
+	Sensor    SensorData
+	Command   string
+	Err       error
+}
+
+// CancelFunc 取消一次 Subscribe 订阅
+type CancelFunc func()
+
+// streamSubscriber 持有一个订阅者的接收 channel
+type streamSubscriber struct {
+	ch chan Reading
+}
+
+// StreamBroker 把一个设备的传感器数据和指令回显汇聚成统一的 Reading 流，并分发给
+// 任意数量的订阅者。组件如果实现了可选的 component.StreamingSensor 接口就直接转发，
+// 否则由 PollReadData 按配置的速率轮询 ReadData() 兜底
+type StreamBroker struct {
+	deviceID string
+	mutex    sync.RWMutex
+	subs     []*streamSubscriber
+}
+
+// NewStreamBroker 创建一个属于指定设备的流式数据 broker
+func NewStreamBroker(deviceID string) *StreamBroker {
+	return &StreamBroker{deviceID: deviceID}
+}
+
+// Subscribe 订阅该设备的 Reading 流，返回的 channel 会在调用 CancelFunc 取消订阅后关闭
+func (b *StreamBroker) Subscribe() (<-chan Reading, CancelFunc) {
+	sub := &streamSubscriber{ch: make(chan Reading, 32)}
+
+	b.mutex.Lock()
+	b.subs = append(b.subs, sub)
+	b.mutex.Unlock()
+
+	cancel := func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		for i, s := range b.subs {
+			if s == sub {
+				b.subs = append(b.subs[:i], b.subs[i+1:]...)
+				close(sub.ch)
+				break
+			}
+		}
+	}
+	return sub.ch, cancel
+}
+
+// Publish 把一条 Reading 推送给所有订阅者；订阅者处理不及时时丢弃该条数据，而不是阻塞发布方
+func (b *StreamBroker) Publish(r Reading) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	for _, sub := range b.subs {
+		select {
+		case sub.ch <- r:
+		default:
+		}
+	}
+}
+
+// PollReadData 在组件不支持主动推送的情况下，按固定速率轮询 readFn 并把结果发布出去，
+// 直到 ctx 被取消
+func (b *StreamBroker) PollReadData(ctx context.Context, rate time.Duration, readFn func() (SensorData, error)) {
+	ticker := time.NewTicker(rate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			data, err := readFn()
+			if err != nil {
+				b.Publish(Reading{DeviceID: b.deviceID, Kind: ReadingError, Timestamp: time.Now(), Err: err})
+				continue
+			}
+			b.Publish(Reading{DeviceID: b.deviceID, Kind: ReadingSensor, Timestamp: time.Now(), Sensor: data})
+		}
+	}
+}
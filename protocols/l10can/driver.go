@@ -0,0 +1,85 @@
+// Package l10can 实现 L10 手部设备在 CAN 总线上的帧格式：0x01 前缀对应手指姿态，
+// 0x04 前缀对应手掌姿态，CAN ID 取自设备的手型（define.HandType）。
+package l10can
+
+import (
+	"fmt"
+
+	"hands/communication"
+	"hands/define"
+	"hands/device"
+)
+
+func init() {
+	device.RegisterProtocolDriver("L10", newDriverFromParams)
+}
+
+// Driver 是 L10 的 device.ProtocolDriver 实现
+type Driver struct {
+	canInterface string
+	handType     define.HandType
+}
+
+// NewDriver 创建一个 L10 协议驱动
+func NewDriver(canInterface string, handType define.HandType) *Driver {
+	return &Driver{canInterface: canInterface, handType: handType}
+}
+
+// newDriverFromParams 从设备配置参数构造驱动，供驱动注册表使用
+func newDriverFromParams(params map[string]any) (device.ProtocolDriver, error) {
+	canInterface, _ := params["can_interface"].(string)
+	if canInterface == "" {
+		canInterface = "can0"
+	}
+	handType, _ := params["hand_type"].(define.HandType)
+	return NewDriver(canInterface, handType), nil
+}
+
+// Encode 见 device.ProtocolDriver
+func (d *Driver) Encode(cmd device.Command) ([]communication.RawMessage, error) {
+	var data []byte
+
+	switch cmd.Type() {
+	case "SetFingerPose":
+		// 添加 0x01 前缀
+		data = append([]byte{0x01}, cmd.Payload()...)
+		if len(data) > 8 { // CAN 消息数据长度限制
+			return nil, fmt.Errorf("手指姿态数据过长")
+		}
+	case "SetPalmPose":
+		// 添加 0x04 前缀
+		data = append([]byte{0x04}, cmd.Payload()...)
+		if len(data) > 8 { // CAN 消息数据长度限制
+			return nil, fmt.Errorf("手掌姿态数据过长")
+		}
+	default:
+		return nil, fmt.Errorf("L10 不支持的指令类型: %s", cmd.Type())
+	}
+
+	return []communication.RawMessage{{
+		Interface: d.canInterface,
+		ID:        uint32(d.handType),
+		Data:      data,
+	}}, nil
+}
+
+// Decode 见 device.ProtocolDriver
+func (d *Driver) Decode(msg communication.RawMessage) (device.Event, error) {
+	if len(msg.Data) == 0 {
+		return device.Event{}, fmt.Errorf("空的 CAN 帧")
+	}
+
+	switch msg.Data[0] {
+	case 0x01:
+		return device.Event{Type: "SetFingerPose", Payload: msg.Data[1:]}, nil
+	case 0x04:
+		return device.Event{Type: "SetPalmPose", Payload: msg.Data[1:]}, nil
+	default:
+		return device.Event{}, fmt.Errorf("未知的 L10 帧前缀: 0x%X", msg.Data[0])
+	}
+}
+
+// Capabilities 见 device.ProtocolDriver
+func (d *Driver) Capabilities() []string {
+	return []string{"SetFingerPose", "SetPalmPose"}
+}